@@ -0,0 +1,180 @@
+// Package async provides generic, result-bearing tasks with async/await style composition on top
+// of ksat's fire-and-forget Task primitives.
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// Task is a unit of work that produces a result of type T.
+type Task[T any] func(context.Context) (T, error)
+
+// TaskPanicError wraps a value recovered from a panicking Task along with the stack captured at
+// the point of the panic.
+type TaskPanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *TaskPanicError) Error() string {
+	return fmt.Sprintf("async: task panicked: %v\n%s", e.Value, e.Stack)
+}
+
+// Handle represents an in-flight or completed Task[T].
+type Handle[T any] struct {
+	done   chan struct{}
+	result T
+	err    error
+}
+
+// Start launches fn in a goroutine and returns a Handle that can be awaited for its result. A
+// panic inside fn is recovered and surfaced as a *TaskPanicError rather than crashing the process.
+func Start[T any](ctx context.Context, fn Task[T]) *Handle[T] {
+	h := &Handle[T]{done: make(chan struct{})}
+
+	go func() {
+		defer close(h.done)
+		defer func() {
+			if r := recover(); r != nil {
+				h.err = &TaskPanicError{Value: r, Stack: debug.Stack()}
+			}
+		}()
+		h.result, h.err = fn(ctx)
+	}()
+
+	return h
+}
+
+// Await blocks until h completes and returns its result, or returns ctx's error if ctx is
+// cancelled first.
+func (h *Handle[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-h.done:
+		return h.result, h.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// ContinueWith awaits h and, once it succeeds, starts fn with h's result as input. If h fails, the
+// returned Handle carries h's error without ever running fn.
+func ContinueWith[T, U any](ctx context.Context, h *Handle[T], fn func(context.Context, T) (U, error)) *Handle[U] {
+	return Start(ctx, func(ctx context.Context) (U, error) {
+		res, err := h.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(ctx, res)
+	})
+}
+
+// AfterBoth awaits a and b and feeds both results into fn once they have both completed. If either
+// fails, the returned Handle carries that error without running fn.
+func AfterBoth[A, B, U any](ctx context.Context, a *Handle[A], b *Handle[B], fn func(context.Context, A, B) (U, error)) *Handle[U] {
+	return Start(ctx, func(ctx context.Context) (U, error) {
+		resA, err := a.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		resB, err := b.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(ctx, resA, resB)
+	})
+}
+
+// WaitAllOption configures WaitAll.
+type WaitAllOption func(*waitAllOptions)
+
+type waitAllOptions struct {
+	failFast bool
+}
+
+// WithFailFast makes WaitAll return as soon as any handle errors, instead of waiting for the rest
+// of the handles to finish.
+func WithFailFast() WaitAllOption {
+	return func(o *waitAllOptions) { o.failFast = true }
+}
+
+// WaitAll awaits every handle and returns their results in the same order as handles. Without
+// WithFailFast it always waits for every handle to complete before returning the first error
+// encountered; with WithFailFast it returns as soon as any handle errors.
+func WaitAll[T any](ctx context.Context, handles []*Handle[T], opts ...WaitAllOption) ([]T, error) {
+	var o waitAllOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]T, len(handles))
+	errs := make([]error, len(handles))
+
+	var wg sync.WaitGroup
+	for i, h := range handles {
+		wg.Add(1)
+		go func(i int, h *Handle[T]) {
+			defer wg.Done()
+			res, err := h.Await(ctx)
+			results[i] = res
+			errs[i] = err
+			if err != nil && o.failFast {
+				cancel()
+			}
+		}(i, h)
+	}
+	wg.Wait()
+
+	// A still-pending handle that gets interrupted by our own fail-fast cancel returns
+	// context.Canceled from Await even though it never actually failed. Prefer a genuine task
+	// error over that artifact so the real failure isn't masked.
+	var cancelErr error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, context.Canceled):
+			if cancelErr == nil {
+				cancelErr = err
+			}
+		default:
+			return results, err
+		}
+	}
+	return results, cancelErr
+}
+
+// WaitAny returns the result of whichever handle completes first, or ctx's error if ctx is
+// cancelled before any of them finish.
+func WaitAny[T any](ctx context.Context, handles ...*Handle[T]) (T, error) {
+	type outcome struct {
+		val T
+		err error
+	}
+
+	out := make(chan outcome, len(handles))
+	for _, h := range handles {
+		go func(h *Handle[T]) {
+			val, err := h.Await(ctx)
+			out <- outcome{val, err}
+		}(h)
+	}
+
+	select {
+	case o := <-out:
+		return o.val, o.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
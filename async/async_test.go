@@ -0,0 +1,105 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAwait(t *testing.T) {
+	h := Start(context.Background(), func(context.Context) (int, error) {
+		return 42, nil
+	})
+
+	got, err := h.Await(context.Background())
+	if err != nil {
+		t.Fatalf("Await returned error: %v", err)
+	}
+	if got != 42 {
+		t.Fatalf("Await returned %d, want 42", got)
+	}
+}
+
+func TestAwaitPanicRecovered(t *testing.T) {
+	h := Start(context.Background(), func(context.Context) (int, error) {
+		panic("boom")
+	})
+
+	_, err := h.Await(context.Background())
+	var panicErr *TaskPanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Await returned %v, want *TaskPanicError", err)
+	}
+	if panicErr.Value != "boom" {
+		t.Fatalf("panic value = %v, want %q", panicErr.Value, "boom")
+	}
+}
+
+func TestWaitAllReturnsFirstError(t *testing.T) {
+	ctx := context.Background()
+	wantErr := errors.New("boom")
+
+	slow := Start(ctx, func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+	failing := Start(ctx, func(context.Context) (int, error) {
+		return 0, wantErr
+	})
+
+	_, err := WaitAll(ctx, []*Handle[int]{slow, failing})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("WaitAll err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestWaitAllFailFastDoesNotMaskRealError(t *testing.T) {
+	ctx := context.Background()
+	wantErr := errors.New("boom")
+
+	slow := Start(ctx, func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+	failing := Start(ctx, func(context.Context) (int, error) {
+		return 0, wantErr
+	})
+
+	_, err := WaitAll(ctx, []*Handle[int]{slow, failing}, WithFailFast())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("WaitAll err = %v, want %v (not a context.Canceled artifact)", err, wantErr)
+	}
+}
+
+func TestWaitAny(t *testing.T) {
+	ctx := context.Background()
+
+	slow := Start(ctx, func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(100 * time.Millisecond):
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+	fast := Start(ctx, func(context.Context) (int, error) {
+		return 2, nil
+	})
+
+	got, err := WaitAny(ctx, slow, fast)
+	if err != nil {
+		t.Fatalf("WaitAny returned error: %v", err)
+	}
+	if got != 2 {
+		t.Fatalf("WaitAny returned %d, want 2", got)
+	}
+}
@@ -0,0 +1,141 @@
+package ksat
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime/debug"
+	"time"
+)
+
+// PanicError wraps a value recovered from a panicking Task along with the stack captured at the
+// point of the panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("ksat: task panicked: %v\n%s", e.Value, e.Stack)
+}
+
+// WithRecover wraps t so a panic inside Run is recovered and returned as a *PanicError instead of
+// crashing the process. This is particularly useful under List.Run, where a panicking goroutine
+// would otherwise take down the whole program.
+func WithRecover(t Task) Task {
+	return Func(func(ctx context.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = &PanicError{Value: r, Stack: debug.Stack()}
+			}
+		}()
+		return t.Run(ctx)
+	})
+}
+
+// BackoffKind selects the shape of delay a RetryStrategy uses between attempts.
+type BackoffKind int
+
+const (
+	// ConstantBackoff waits BaseDelay between every attempt. It is the zero value.
+	ConstantBackoff BackoffKind = iota
+	// ExponentialBackoff doubles the wait after every failed attempt, up to MaxDelay.
+	ExponentialBackoff
+)
+
+// RetryStrategy configures WithRetry.
+type RetryStrategy struct {
+	// Kind selects constant or exponential backoff.
+	Kind BackoffKind
+	// BaseDelay is the delay before the first retry, and the fixed delay under ConstantBackoff.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed delay under ExponentialBackoff. Zero means no cap.
+	MaxDelay time.Duration
+	// MaxAttempts caps the total number of attempts, including the first. Zero means retry
+	// until ctx is cancelled.
+	MaxAttempts int
+	// Jitter, when true, randomizes each computed delay to somewhere in [0, delay).
+	Jitter bool
+}
+
+func (s RetryStrategy) delay(attempt int) time.Duration {
+	d := s.BaseDelay
+	if s.Kind == ExponentialBackoff {
+		// Double one attempt at a time rather than computing BaseDelay*2^attempt directly, so a
+		// long-running retry loop clamps at MaxDelay instead of overflowing time.Duration into a
+		// negative, effectively zero wait.
+		for i := 1; i < attempt; i++ {
+			next := d * 2
+			if d > 0 && (next <= d || (s.MaxDelay > 0 && next >= s.MaxDelay)) {
+				d = s.maxDelay()
+				break
+			}
+			d = next
+		}
+	}
+	if s.Jitter && d > 0 {
+		d = time.Duration(rand.Int63n(int64(d)))
+	}
+	return d
+}
+
+// maxDelay returns the delay to clamp to once growth should stop: MaxDelay if one is configured,
+// or the largest representable duration otherwise.
+func (s RetryStrategy) maxDelay() time.Duration {
+	if s.MaxDelay > 0 {
+		return s.MaxDelay
+	}
+	return math.MaxInt64
+}
+
+// WithRetry wraps t so Run retries on error according to strategy, waiting between attempts and
+// stopping early if ctx is cancelled. It returns the last error once strategy.MaxAttempts is
+// exhausted.
+func WithRetry(t Task, strategy RetryStrategy) Task {
+	return Func(func(ctx context.Context) error {
+		var err error
+		for attempt := 1; strategy.MaxAttempts == 0 || attempt <= strategy.MaxAttempts; attempt++ {
+			if err = t.Run(ctx); err == nil {
+				return nil
+			}
+
+			if strategy.MaxAttempts != 0 && attempt == strategy.MaxAttempts {
+				break
+			}
+
+			select {
+			case <-time.After(strategy.delay(attempt)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return err
+	})
+}
+
+// WithTimeout wraps t so the context passed to t is cancelled after d. Run returns whatever t
+// returns; a t that ignores its context is not stopped.
+func WithTimeout(t Task, d time.Duration) Task {
+	return Func(func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		return t.Run(ctx)
+	})
+}
+
+// Limiter paces how often a task is allowed to run. Wait blocks until the caller is permitted to
+// proceed, or returns ctx's error if ctx is cancelled first.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// WithRateLimit wraps t so Run first waits on limiter before invoking t.
+func WithRateLimit(t Task, limiter Limiter) Task {
+	return Func(func(ctx context.Context) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		return t.Run(ctx)
+	})
+}
@@ -0,0 +1,126 @@
+package ksat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithRetryStopsAtMaxAttempts(t *testing.T) {
+	wantErr := errors.New("always fails")
+	var attempts int
+
+	t1 := WithRetry(Func(func(context.Context) error {
+		attempts++
+		return wantErr
+	}), RetryStrategy{BaseDelay: time.Millisecond, MaxAttempts: 3})
+
+	err := t1.Run(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Run err = %v, want %v", err, wantErr)
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestWithRetrySucceedsBeforeMaxAttempts(t *testing.T) {
+	var attempts int
+
+	t1 := WithRetry(Func(func(context.Context) error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("not yet")
+		}
+		return nil
+	}), RetryStrategy{BaseDelay: time.Millisecond, MaxAttempts: 5})
+
+	if err := t1.Run(context.Background()); err != nil {
+		t.Fatalf("Run err = %v, want nil", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestWithRetryStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var attempts int
+
+	t1 := WithRetry(Func(func(context.Context) error {
+		attempts++
+		cancel()
+		return errors.New("not yet")
+	}), RetryStrategy{BaseDelay: time.Second})
+
+	err := t1.Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run err = %v, want context.Canceled", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryStrategyExponentialDelayClampsPastOverflow(t *testing.T) {
+	s := RetryStrategy{
+		Kind:      ExponentialBackoff,
+		BaseDelay: time.Millisecond,
+		MaxDelay:  30 * time.Second,
+	}
+
+	for _, attempt := range []int{45, 58, 1000} {
+		d := s.delay(attempt)
+		if d <= 0 {
+			t.Fatalf("delay(%d) = %s, want a positive duration", attempt, d)
+		}
+		if d > s.MaxDelay {
+			t.Fatalf("delay(%d) = %s, want <= MaxDelay %s", attempt, d, s.MaxDelay)
+		}
+	}
+}
+
+func TestRetryStrategyExponentialDelayIsMonotonicUntilCapped(t *testing.T) {
+	s := RetryStrategy{
+		Kind:      ExponentialBackoff,
+		BaseDelay: time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	prev := s.delay(1)
+	for attempt := 2; attempt <= 20; attempt++ {
+		d := s.delay(attempt)
+		if d < prev {
+			t.Fatalf("delay(%d) = %s < delay(%d) = %s, want non-decreasing", attempt, d, attempt-1, prev)
+		}
+		prev = d
+	}
+}
+
+func TestWithRecoverConvertsPanic(t *testing.T) {
+	t1 := WithRecover(Func(func(context.Context) error {
+		panic("boom")
+	}))
+
+	err := t1.Run(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Run err = %v, want *PanicError", err)
+	}
+	if panicErr.Value != "boom" {
+		t.Fatalf("panic value = %v, want %q", panicErr.Value, "boom")
+	}
+}
+
+func TestWithTimeoutCancelsSlowTask(t *testing.T) {
+	t1 := WithTimeout(Func(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}), 10*time.Millisecond)
+
+	err := t1.Run(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run err = %v, want context.DeadlineExceeded", err)
+	}
+}
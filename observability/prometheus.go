@@ -0,0 +1,79 @@
+package observability
+
+import (
+	"time"
+
+	"github.com/chrisdoherty4/ksat"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusObserver is a ksat.Observer that exposes task counts, failures, and durations as
+// Prometheus metrics. It implements prometheus.Collector, so register it with a
+// prometheus.Registerer to expose its metrics.
+type PrometheusObserver struct {
+	started  *prometheus.CounterVec
+	finished *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	stalled  *prometheus.CounterVec
+}
+
+// NewPrometheusObserver creates a PrometheusObserver with metrics named ksat_task_*, labeled by
+// task name.
+func NewPrometheusObserver() *PrometheusObserver {
+	return &PrometheusObserver{
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ksat_task_started_total",
+			Help: "Total number of tasks started.",
+		}, []string{"task"}),
+		finished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ksat_task_finished_total",
+			Help: "Total number of tasks finished, labeled by outcome.",
+		}, []string{"task", "outcome"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "ksat_task_duration_seconds",
+			Help: "Task execution duration in seconds.",
+		}, []string{"task"}),
+		stalled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ksat_task_stalled_total",
+			Help: "Total number of times a task exceeded its StalledThreshold.",
+		}, []string{"task"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (o *PrometheusObserver) Describe(ch chan<- *prometheus.Desc) {
+	o.started.Describe(ch)
+	o.finished.Describe(ch)
+	o.duration.Describe(ch)
+	o.stalled.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (o *PrometheusObserver) Collect(ch chan<- prometheus.Metric) {
+	o.started.Collect(ch)
+	o.finished.Collect(ch)
+	o.duration.Collect(ch)
+	o.stalled.Collect(ch)
+}
+
+// OnStart increments the started counter for name.
+func (o *PrometheusObserver) OnStart(name string) {
+	o.started.WithLabelValues(name).Inc()
+}
+
+// OnFinish increments the finished counter for name, labeled by outcome, and observes dur.
+func (o *PrometheusObserver) OnFinish(name string, err error, dur time.Duration) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	o.finished.WithLabelValues(name, outcome).Inc()
+	o.duration.WithLabelValues(name).Observe(dur.Seconds())
+}
+
+// OnStalled increments the stalled counter for name.
+func (o *PrometheusObserver) OnStalled(name string) {
+	o.stalled.WithLabelValues(name).Inc()
+}
+
+var _ ksat.Observer = (*PrometheusObserver)(nil)
@@ -0,0 +1,41 @@
+// Package observability provides ready-made ksat.Observer implementations for structured logging
+// and metrics, so consumers don't have to re-implement them around raw tasks.
+package observability
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/chrisdoherty4/ksat"
+)
+
+// SlogObserver is a ksat.Observer that logs task lifecycle events through a *slog.Logger.
+type SlogObserver struct {
+	Logger *slog.Logger
+}
+
+// NewSlogObserver returns a SlogObserver that logs through logger.
+func NewSlogObserver(logger *slog.Logger) *SlogObserver {
+	return &SlogObserver{Logger: logger}
+}
+
+// OnStart logs task start at debug level.
+func (o *SlogObserver) OnStart(name string) {
+	o.Logger.Debug("task started", "task", name)
+}
+
+// OnFinish logs task completion, at error level if err is non-nil and debug level otherwise.
+func (o *SlogObserver) OnFinish(name string, err error, dur time.Duration) {
+	if err != nil {
+		o.Logger.Error("task failed", "task", name, "error", err, "duration", dur)
+		return
+	}
+	o.Logger.Debug("task finished", "task", name, "duration", dur)
+}
+
+// OnStalled logs a stalled task at warn level.
+func (o *SlogObserver) OnStalled(name string) {
+	o.Logger.Warn("task stalled", "task", name)
+}
+
+var _ ksat.Observer = (*SlogObserver)(nil)
@@ -0,0 +1,35 @@
+package ksat
+
+import "time"
+
+// Observer receives notifications about task execution in a List or Chain.
+type Observer interface {
+	// OnStart is called when a task begins running.
+	OnStart(name string)
+	// OnFinish is called when a task returns, with the error it returned (if any) and how long
+	// it ran for.
+	OnFinish(name string, err error, dur time.Duration)
+	// OnStalled is called once a running task has exceeded its StalledThreshold without
+	// returning. It may be called at most once per task execution.
+	OnStalled(name string)
+}
+
+// observe runs fn, notifying obs (if non-nil) of its start, completion, and any stall past
+// threshold. It is shared by List and Chain.
+func observe(obs Observer, threshold time.Duration, name string, fn func() error) error {
+	if obs == nil {
+		return fn()
+	}
+
+	obs.OnStart(name)
+	start := time.Now()
+
+	if threshold > 0 {
+		timer := time.AfterFunc(threshold, func() { obs.OnStalled(name) })
+		defer timer.Stop()
+	}
+
+	err := fn()
+	obs.OnFinish(name, err, time.Since(start))
+	return err
+}
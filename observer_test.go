@@ -0,0 +1,75 @@
+package ksat
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingObserver struct {
+	mu      sync.Mutex
+	started []string
+	stalled []string
+	errs    []error
+}
+
+func (o *recordingObserver) OnStart(name string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.started = append(o.started, name)
+}
+
+func (o *recordingObserver) OnFinish(_ string, err error, _ time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.errs = append(o.errs, err)
+}
+
+func (o *recordingObserver) OnStalled(name string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.stalled = append(o.stalled, name)
+}
+
+func TestChainObserverNotifiesStartAndStall(t *testing.T) {
+	obs := &recordingObserver{}
+	c := Chain{Observer: obs, StalledThreshold: 5 * time.Millisecond}
+	c.AddNamed("slow", Func(func(context.Context) error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	}))
+
+	if err := c.Run(context.Background()); err != nil {
+		t.Fatalf("Run err = %v, want nil", err)
+	}
+
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	if len(obs.started) != 1 || obs.started[0] != "slow" {
+		t.Fatalf("started = %v, want [slow]", obs.started)
+	}
+	if len(obs.stalled) != 1 || obs.stalled[0] != "slow" {
+		t.Fatalf("stalled = %v, want [slow]", obs.stalled)
+	}
+}
+
+func TestChainObserverReportsTaskError(t *testing.T) {
+	wantErr := errors.New("boom")
+	obs := &recordingObserver{}
+	c := Chain{Observer: obs}
+	c.AddNamed("failing", Func(func(context.Context) error {
+		return wantErr
+	}))
+
+	if err := c.Run(context.Background()); !errors.Is(err, wantErr) {
+		t.Fatalf("Run err = %v, want %v", err, wantErr)
+	}
+
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	if len(obs.errs) != 1 || !errors.Is(obs.errs[0], wantErr) {
+		t.Fatalf("observer errs = %v, want [%v]", obs.errs, wantErr)
+	}
+}
@@ -0,0 +1,52 @@
+package persist
+
+import (
+	"context"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+// BoltStore is a Store backed by a single bucket in a bbolt database.
+type BoltStore struct {
+	db     *bbolt.DB
+	bucket []byte
+}
+
+// NewBoltStore returns a BoltStore backed by bucket in db, creating bucket if it doesn't already
+// exist.
+func NewBoltStore(db *bbolt.DB, bucket string) (*BoltStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("persist: create bucket %q: %w", bucket, err)
+	}
+
+	return &BoltStore{db: db, bucket: []byte(bucket)}, nil
+}
+
+// Put writes record under key, overwriting any existing record.
+func (s *BoltStore) Put(_ context.Context, key string, record []byte) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(s.bucket).Put([]byte(key), record)
+	})
+}
+
+// Delete removes the record stored under key, if any.
+func (s *BoltStore) Delete(_ context.Context, key string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(s.bucket).Delete([]byte(key))
+	})
+}
+
+// Iterate calls fn with every key and record currently in the bucket. Iteration stops and the
+// error is returned if fn returns a non-nil error.
+func (s *BoltStore) Iterate(_ context.Context, fn func(key string, record []byte) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(s.bucket).ForEach(func(k, v []byte) error {
+			return fn(string(k), v)
+		})
+	})
+}
@@ -0,0 +1,125 @@
+// Package persist wraps ksat's Task primitives with durable storage so registered tasks survive
+// process restarts, similar to an outbox/taskbasket pattern.
+package persist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrisdoherty4/ksat"
+)
+
+// Codec encodes and decodes Tasks so they can be written to a Store. Because ksat.Task is an
+// interface, concrete task types must be registered with a Registry before they can be decoded.
+type Codec interface {
+	Encode(ksat.Task) ([]byte, error)
+	Decode([]byte) (ksat.Task, error)
+}
+
+// Store persists the raw, codec-encoded record for a task under an opaque key chosen by Basket.
+type Store interface {
+	Put(ctx context.Context, key string, record []byte) error
+	Delete(ctx context.Context, key string) error
+	Iterate(ctx context.Context, fn func(key string, record []byte) error) error
+}
+
+// Option configures a Basket.
+type Option func(*Basket)
+
+// WithRetryInterval sets the delay between retry attempts. The default is one second.
+func WithRetryInterval(d time.Duration) Option {
+	return func(b *Basket) { b.retryInterval = d }
+}
+
+// WithErrorHandler registers a handler invoked with every non-nil error a task returns, before it
+// is retried.
+func WithErrorHandler(e ksat.ErrorHandler) Option {
+	return func(b *Basket) { b.errHandler = e }
+}
+
+// Basket persists every task added to it, then retries it with backoff until it returns nil,
+// deleting its record only then. This turns ksat from a fire-and-forget runner into a crash-safe
+// task manager suitable for outbox-style workloads.
+type Basket struct {
+	store         Store
+	codec         Codec
+	retryInterval time.Duration
+	errHandler    ksat.ErrorHandler
+}
+
+// New creates a Basket backed by store and codec, then decodes and relaunches any records already
+// present in store, so a Basket created after a crash resumes in-flight tasks automatically.
+func New(ctx context.Context, store Store, codec Codec, opts ...Option) (*Basket, error) {
+	b := &Basket{
+		store:         store,
+		codec:         codec,
+		retryInterval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	type record struct {
+		key string
+		t   ksat.Task
+	}
+	var pending []record
+
+	err := store.Iterate(ctx, func(key string, raw []byte) error {
+		t, err := codec.Decode(raw)
+		if err != nil {
+			return fmt.Errorf("persist: decode task %q: %w", key, err)
+		}
+		pending = append(pending, record{key: key, t: t})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("persist: replay store: %w", err)
+	}
+
+	for _, r := range pending {
+		b.run(ctx, r.key, r.t)
+	}
+
+	return b, nil
+}
+
+// Add encodes and persists t under key, then launches a goroutine that retries t until it returns
+// nil, deleting its record from the store once it succeeds.
+func (b *Basket) Add(ctx context.Context, key string, t ksat.Task) error {
+	record, err := b.codec.Encode(t)
+	if err != nil {
+		return fmt.Errorf("persist: encode task %q: %w", key, err)
+	}
+	if err := b.store.Put(ctx, key, record); err != nil {
+		return fmt.Errorf("persist: put task %q: %w", key, err)
+	}
+
+	b.run(ctx, key, t)
+	return nil
+}
+
+func (b *Basket) run(ctx context.Context, key string, t ksat.Task) {
+	go func() {
+		for {
+			err := t.Run(ctx)
+			if err == nil {
+				if delErr := b.store.Delete(ctx, key); delErr != nil && b.errHandler != nil {
+					b.errHandler(delErr)
+				}
+				return
+			}
+
+			if b.errHandler != nil {
+				b.errHandler(err)
+			}
+
+			select {
+			case <-time.After(b.retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
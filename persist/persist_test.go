@@ -0,0 +1,138 @@
+package persist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chrisdoherty4/ksat"
+)
+
+type memStore struct {
+	mu      sync.Mutex
+	records map[string][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{records: make(map[string][]byte)}
+}
+
+func (s *memStore) Put(_ context.Context, key string, record []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[key] = record
+	return nil
+}
+
+func (s *memStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func (s *memStore) Iterate(_ context.Context, fn func(key string, record []byte) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.records {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memStore) has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.records[key]
+	return ok
+}
+
+// countingTask fails its first `failures` runs, then succeeds.
+type countingTask struct {
+	failures int32
+	ran      int32
+}
+
+func (t *countingTask) Run(context.Context) error {
+	n := atomic.AddInt32(&t.ran, 1)
+	if n <= atomic.LoadInt32(&t.failures) {
+		return fmt.Errorf("not ready yet")
+	}
+	return nil
+}
+
+// nameCodec encodes/decodes tasks as a registry lookup name.
+type nameCodec struct {
+	registry *Registry
+	name     string
+}
+
+func (c *nameCodec) Encode(ksat.Task) ([]byte, error) {
+	return []byte(c.name), nil
+}
+
+func (c *nameCodec) Decode(record []byte) (ksat.Task, error) {
+	t, ok := c.registry.New(string(record))
+	if !ok {
+		return nil, fmt.Errorf("unregistered task %q", record)
+	}
+	return t, nil
+}
+
+func waitUntilDeleted(t *testing.T, store *memStore, key string) {
+	t.Helper()
+	deadline := time.After(time.Second)
+	for store.has(key) {
+		select {
+		case <-deadline:
+			t.Fatalf("record %q was never deleted from the store", key)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestBasketRetriesUntilSuccess(t *testing.T) {
+	store := newMemStore()
+	registry := NewRegistry()
+	task := &countingTask{failures: 2}
+	registry.Register("counting", func() ksat.Task { return task })
+	codec := &nameCodec{registry: registry, name: "counting"}
+
+	ctx := context.Background()
+	b, err := New(ctx, store, codec, WithRetryInterval(5*time.Millisecond))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if err := b.Add(ctx, "task-1", task); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	waitUntilDeleted(t, store, "task-1")
+
+	if got := atomic.LoadInt32(&task.ran); got < 3 {
+		t.Fatalf("task ran %d times, want at least 3", got)
+	}
+}
+
+func TestBasketReplaysExistingRecords(t *testing.T) {
+	store := newMemStore()
+	registry := NewRegistry()
+	registry.Register("counting", func() ksat.Task { return &countingTask{} })
+	codec := &nameCodec{registry: registry, name: "counting"}
+
+	if err := store.Put(context.Background(), "replayed", []byte("counting")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if _, err := New(context.Background(), store, codec, WithRetryInterval(5*time.Millisecond)); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	waitUntilDeleted(t, store, "replayed")
+}
@@ -0,0 +1,30 @@
+package persist
+
+import "github.com/chrisdoherty4/ksat"
+
+// Registry maps a type name to a constructor for a concrete ksat.Task type. Register every task
+// type a Codec may need to decode before passing the Registry-backed Codec to New, so records left
+// over from a previous process can be replayed.
+type Registry struct {
+	types map[string]func() ksat.Task
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{types: make(map[string]func() ksat.Task)}
+}
+
+// Register associates name with a constructor for a concrete Task type. Codecs typically prefix
+// their encoded records with name so Decode knows which constructor to use.
+func (r *Registry) Register(name string, newTask func() ksat.Task) {
+	r.types[name] = newTask
+}
+
+// New returns a freshly constructed Task for name, or false if name was never registered.
+func (r *Registry) New(name string) (ksat.Task, bool) {
+	newTask, ok := r.types[name]
+	if !ok {
+		return nil, false
+	}
+	return newTask(), true
+}
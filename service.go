@@ -0,0 +1,144 @@
+package ksat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Service is a long-running unit of work such as an HTTP server, poller, or consumer. Start blocks
+// until ctx is cancelled, then returns once the service has wound down.
+type Service interface {
+	Start(ctx context.Context) error
+}
+
+// ServiceFunc is a convenience type that allows consumers to define inline functions as a Service.
+type ServiceFunc func(context.Context) error
+
+func (fn ServiceFunc) Start(ctx context.Context) error {
+	return fn(ctx)
+}
+
+// Supervisor runs a set of Services concurrently and coordinates their graceful shutdown. The zero
+// value is ready to use. A Supervisor may be reused across multiple calls to Run, one at a time.
+type Supervisor struct {
+	// ShutdownTimeout bounds how long Run waits for every Service to return once shutdown has
+	// been triggered. Zero means wait forever.
+	ShutdownTimeout time.Duration
+
+	services []Service
+
+	mu      sync.Mutex
+	stop    chan struct{}
+	stopped bool
+}
+
+// Add registers svc to be started when Run is called.
+func (s *Supervisor) Add(svc Service) {
+	s.services = append(s.services, svc)
+}
+
+// Stop triggers shutdown as if SIGINT or SIGTERM had been received. It is safe to call more than
+// once and from any goroutine, but only affects the current or most recently started Run.
+func (s *Supervisor) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil && !s.stopped {
+		close(s.stop)
+		s.stopped = true
+	}
+}
+
+// Run starts every registered Service and blocks until a shutdown is triggered by SIGINT, SIGTERM,
+// or a call to Stop, then cancels the context shared by all of them. It waits up to
+// ShutdownTimeout for every Service to return, and returns an error giving the number that didn't.
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.mu.Lock()
+	stop := make(chan struct{})
+	s.stop, s.stopped = stop, false
+	s.mu.Unlock()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, len(s.services))
+	for _, svc := range s.services {
+		go func(svc Service) {
+			done <- svc.Start(ctx)
+		}(svc)
+	}
+
+	select {
+	case <-sig:
+	case <-stop:
+	case <-ctx.Done():
+	}
+	cancel()
+
+	var timeout <-chan time.Time
+	if s.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.ShutdownTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var errs []error
+	for remaining := len(s.services); remaining > 0; {
+		select {
+		case err := <-done:
+			remaining--
+			if err != nil {
+				errs = append(errs, err)
+			}
+		case <-timeout:
+			return fmt.Errorf("ksat: %d service(s) did not shut down within %s", remaining, s.ShutdownTimeout)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ServerService adapts an *http.Server to the Service interface, serving requests until ctx is
+// cancelled and then shutting the server down gracefully.
+type ServerService struct {
+	Server *http.Server
+}
+
+// NewServerService returns a ServerService wrapping server.
+func NewServerService(server *http.Server) *ServerService {
+	return &ServerService{Server: server}
+}
+
+// Start runs the server until ctx is cancelled, then calls Shutdown so in-flight requests can
+// finish before Start returns.
+func (s *ServerService) Start(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.Server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+			return
+		}
+		errCh <- nil
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		if err := s.Server.Shutdown(context.Background()); err != nil {
+			return err
+		}
+		return <-errCh
+	}
+}
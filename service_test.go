@@ -0,0 +1,76 @@
+package ksat
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSupervisorShutdownTimeoutReportsOverrunningService(t *testing.T) {
+	var sup Supervisor
+	sup.ShutdownTimeout = 10 * time.Millisecond
+	sup.Add(ServiceFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil
+	}))
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		sup.Stop()
+	}()
+
+	start := time.Now()
+	err := sup.Run(context.Background())
+	if err == nil {
+		t.Fatal("Run err = nil, want an error reporting the overrunning service")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Run took %s, want it to return around ShutdownTimeout", elapsed)
+	}
+}
+
+func TestSupervisorShutdownWithinTimeout(t *testing.T) {
+	var sup Supervisor
+	sup.ShutdownTimeout = time.Second
+	sup.Add(ServiceFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	}))
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		sup.Stop()
+	}()
+
+	if err := sup.Run(context.Background()); err != nil {
+		t.Fatalf("Run err = %v, want nil", err)
+	}
+}
+
+func TestSupervisorStopWorksAcrossMultipleRuns(t *testing.T) {
+	var sup Supervisor
+	sup.Add(ServiceFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	}))
+
+	for i := 0; i < 2; i++ {
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			sup.Stop()
+		}()
+
+		done := make(chan error, 1)
+		go func() { done <- sup.Run(context.Background()) }()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("run %d: Run err = %v, want nil", i, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("run %d: Stop() did not unblock Run()", i)
+		}
+	}
+}
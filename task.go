@@ -1,6 +1,10 @@
 package ksat
 
-import "context"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 // Task is a runnable unit of work.
 type Task interface {
@@ -17,49 +21,156 @@ func (fn Func) Run(ctx context.Context) error {
 // ErrorHandler is used to handle errors originating from Tasks.
 type ErrorHandler func(error)
 
+// Policy controls how a task's outcome affects the rest of a RunGroup.
+type Policy int
+
+const (
+	// Fail cancels the group and records the task's error when it returns non-nil. This is the
+	// default policy used by Add.
+	Fail Policy = iota
+	// Continue reports the task's error to its ErrorHandler but never cancels the group, for
+	// best-effort or run-forever tasks.
+	Continue
+	// Exit cancels the group as soon as the task returns, whether or not it errored, for tasks
+	// that signal the group should wind down (e.g. a graceful shutdown trigger).
+	Exit
+)
+
 type task struct {
-	t Task
-	e ErrorHandler
+	name string
+	t    Task
+	e    ErrorHandler
+	p    Policy
 }
 
 // List is a list of runnable tasks. Tasks are executed concurrently.
 type List struct {
 	tasks []task
+
+	// Observer, if set, is notified around the execution of every task.
+	Observer Observer
+	// StalledThreshold, if non-zero, is how long a task may run before Observer.OnStalled is
+	// called. It does not stop or cancel the task.
+	StalledThreshold time.Duration
 }
 
-// Add adds t to the list of tasks to be run.
+// Add adds t to the list of tasks to be run using the Fail policy. Use AddWithPolicy to control
+// how t's outcome affects RunGroup.
 func (l *List) Add(t Task, e ErrorHandler) {
-	l.tasks = append(l.tasks, task{t: t, e: e})
+	l.AddWithPolicy(t, e, Fail)
+}
+
+// AddWithPolicy adds t to the list of tasks to be run. p only affects RunGroup; Run always fires
+// every task and ignores p.
+func (l *List) AddWithPolicy(t Task, e ErrorHandler, p Policy) {
+	l.tasks = append(l.tasks, task{t: t, e: e, p: p})
+}
+
+// AddNamed is like Add but attaches name to t so Observer can identify it.
+func (l *List) AddNamed(name string, t Task, e ErrorHandler) {
+	l.tasks = append(l.tasks, task{name: name, t: t, e: e, p: Fail})
 }
 
 // Run runs all tasks in l. Tasks are executed out of order and concurrently. When an error occurs
-// the registered error handler is called. When Run() completes, l is reset.
+// the registered error handler, if any, is called. When Run() completes, l is reset.
 func (l *List) Run(ctx context.Context) {
 	for _, tsk := range l.tasks {
-		go func(task Task, handler ErrorHandler) {
-			if err := task.Run(ctx); err != nil {
-				handler(err)
+		go func(tsk task) {
+			err := l.observe(tsk.name, func() error { return tsk.t.Run(ctx) })
+			if err != nil && tsk.e != nil {
+				tsk.e(err)
 			}
-		}(tsk.t, tsk.e)
+		}(tsk)
 	}
 	l.tasks = nil
 }
 
+// observe runs fn, notifying Observer (if set) of its start, completion, and any stall.
+func (l *List) observe(name string, fn func() error) error {
+	return observe(l.Observer, l.StalledThreshold, name, fn)
+}
+
+// RunGroup runs all tasks in l concurrently against a context derived from ctx, and blocks until
+// every task returns. A task's ErrorHandler, if any, is still called on error. A Fail-policy task
+// that returns a non-nil error, or an Exit-policy task that returns at all, cancels the derived
+// context so its siblings can exit early; the first such error is returned once every task has
+// finished. Continue-policy tasks never cancel the group. When RunGroup returns, l is reset.
+func (l *List) RunGroup(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		groupErr error
+	)
+
+	for _, tsk := range l.tasks {
+		wg.Add(1)
+		go func(tsk task) {
+			defer wg.Done()
+
+			err := l.observe(tsk.name, func() error { return tsk.t.Run(ctx) })
+			if err != nil && tsk.e != nil {
+				tsk.e(err)
+			}
+
+			switch tsk.p {
+			case Fail:
+				if err == nil {
+					return
+				}
+				fallthrough
+			case Exit:
+				mu.Lock()
+				if groupErr == nil {
+					groupErr = err
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}(tsk)
+	}
+
+	wg.Wait()
+	l.tasks = nil
+	return groupErr
+}
+
+type chainTask struct {
+	name string
+	t    Task
+}
+
 // Chain is a list of chained tasks. Tasks are executed in the order they are added.
 type Chain struct {
-	tasks []Task
+	tasks []chainTask
+
+	// Observer, if set, is notified around the execution of every task.
+	Observer Observer
+	// StalledThreshold, if non-zero, is how long a task may run before Observer.OnStalled is
+	// called. It does not stop or cancel the task.
+	StalledThreshold time.Duration
 }
 
 // Add adds all t's to c in the order they are specified.
 func (c *Chain) Add(t ...Task) {
-	c.tasks = append(c.tasks, t...)
+	for _, tsk := range t {
+		c.tasks = append(c.tasks, chainTask{t: tsk})
+	}
+}
+
+// AddNamed is like Add but attaches name to t so Observer can identify it.
+func (c *Chain) AddNamed(name string, t Task) {
+	c.tasks = append(c.tasks, chainTask{name: name, t: t})
 }
 
 // Run runs all tasks in c. Tasks are run in the order they were added. If a task fails the error
 // is returned and subsequent tasks are not run. When Run() completes, c is reset.
 func (c *Chain) Run(ctx context.Context) error {
 	for _, tsk := range c.tasks {
-		if err := tsk.Run(ctx); err != nil {
+		err := observe(c.Observer, c.StalledThreshold, tsk.name, func() error { return tsk.t.Run(ctx) })
+		if err != nil {
 			return err
 		}
 	}
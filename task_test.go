@@ -0,0 +1,86 @@
+package ksat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunGroupFailPolicyCancelsSiblings(t *testing.T) {
+	wantErr := errors.New("boom")
+
+	var l List
+	cancelled := make(chan struct{})
+	l.AddWithPolicy(Func(func(context.Context) error {
+		return wantErr
+	}), func(error) {}, Fail)
+	l.AddWithPolicy(Func(func(ctx context.Context) error {
+		<-ctx.Done()
+		close(cancelled)
+		return ctx.Err()
+	}), func(error) {}, Continue)
+
+	err := l.RunGroup(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("RunGroup err = %v, want %v", err, wantErr)
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("sibling task was not cancelled")
+	}
+}
+
+func TestRunGroupContinuePolicyDoesNotCancel(t *testing.T) {
+	var l List
+	l.AddWithPolicy(Func(func(context.Context) error {
+		return errors.New("ignored")
+	}), func(error) {}, Continue)
+	l.AddWithPolicy(Func(func(ctx context.Context) error {
+		select {
+		case <-time.After(20 * time.Millisecond):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}), func(error) {}, Fail)
+
+	if err := l.RunGroup(context.Background()); err != nil {
+		t.Fatalf("RunGroup err = %v, want nil", err)
+	}
+}
+
+func TestRunGroupExitPolicyCancelsOnSuccess(t *testing.T) {
+	var l List
+	l.AddWithPolicy(Func(func(context.Context) error {
+		return nil
+	}), func(error) {}, Exit)
+	l.AddWithPolicy(Func(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}), func(error) {}, Continue)
+
+	if err := l.RunGroup(context.Background()); err != nil {
+		t.Fatalf("RunGroup err = %v, want nil", err)
+	}
+}
+
+func TestRunWithNilErrorHandlerDoesNotPanic(t *testing.T) {
+	done := make(chan struct{})
+
+	var l List
+	l.AddWithPolicy(Func(func(context.Context) error {
+		defer close(done)
+		return errors.New("boom")
+	}), nil, Continue)
+
+	l.Run(context.Background())
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("task never ran")
+	}
+}